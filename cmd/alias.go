@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"please/install"
+	"please/ui"
+)
+
+var aliasCmd = &cobra.Command{
+	Use:   "alias",
+	Short: "Manage the 'pls' shell alias",
+}
+
+var aliasInstallCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install the 'pls' shortcut for the current shell",
+	RunE: func(c *cobra.Command, args []string) error {
+		return installAlias()
+	},
+}
+
+var aliasUninstallCmd = &cobra.Command{
+	Use:   "uninstall",
+	Short: "Remove the 'pls' shortcut",
+	RunE: func(c *cobra.Command, args []string) error {
+		return uninstallAlias()
+	},
+}
+
+func init() {
+	aliasCmd.AddCommand(aliasInstallCmd)
+	aliasCmd.AddCommand(aliasUninstallCmd)
+}
+
+// installAlias creates the "pls" shortcut for the current platform and
+// shell. On Linux and macOS an "ol" link is created alongside it for
+// backwards compatibility; on Windows only pls.ps1 is written, with no "ol".
+func installAlias() error {
+	ui.PrintRainbowBanner()
+	compat := " (with 'ol' for backwards compatibility)"
+	if runtime.GOOS == "windows" {
+		compat = ""
+	}
+	fmt.Printf("\n%s🔧 Installing 'pls' alias%s...%s\n\n", ui.ColorBold+ui.ColorYellow, compat, ui.ColorReset)
+
+	execPath, err := os.Executable()
+	if err != nil {
+		fmt.Printf("%s❌ Failed to get executable path: %v%s\n", ui.ColorRed, err, ui.ColorReset)
+		return err
+	}
+
+	result, err := install.Install(execPath)
+	if err != nil {
+		fmt.Printf("%s❌ Failed to install alias: %v%s\n", ui.ColorRed, err, ui.ColorReset)
+		return err
+	}
+
+	for _, step := range result.Steps {
+		fmt.Printf("%s✅ %s%s\n", ui.ColorGreen, step, ui.ColorReset)
+	}
+
+	fmt.Println()
+	ui.PrintInstallationSuccess()
+	return nil
+}
+
+// uninstallAlias removes everything a previous installAlias may have set up.
+func uninstallAlias() error {
+	ui.PrintRainbowBanner()
+	fmt.Printf("\n%s🗑️  Removing aliases...%s\n\n", ui.ColorBold+ui.ColorYellow, ui.ColorReset)
+
+	execPath, err := os.Executable()
+	if err != nil {
+		fmt.Printf("%s❌ Failed to get executable path: %v%s\n", ui.ColorRed, err, ui.ColorReset)
+		return err
+	}
+
+	result, err := install.Uninstall(execPath)
+	if err != nil {
+		fmt.Printf("%s❌ Failed to remove alias: %v%s\n", ui.ColorRed, err, ui.ColorReset)
+		return err
+	}
+
+	for _, step := range result.Steps {
+		fmt.Printf("%s✅ %s%s\n", ui.ColorGreen, step, ui.ColorReset)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"please/config"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "View or change please's saved configuration",
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print a single config value",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("no config found: %w", err)
+		}
+
+		value, err := configField(cfg, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(value)
+		return nil
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a single config value and save it",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(c *cobra.Command, args []string) error {
+		cfg, err := config.Load()
+		if err != nil {
+			cfg = config.CreateDefault()
+		}
+
+		if err := setConfigField(cfg, args[0], args[1]); err != nil {
+			return err
+		}
+
+		return config.Save(cfg)
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+}
+
+// configField returns the string value of a jsonTagged field on cfg, e.g.
+// "provider" or "script_type".
+func configField(cfg interface{}, key string) (string, error) {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if tag == key {
+			return fmt.Sprintf("%v", v.Field(i).Interface()), nil
+		}
+	}
+	return "", fmt.Errorf("unknown config key: %s", key)
+}
+
+// setConfigField sets the string value of a jsonTagged field on cfg.
+func setConfigField(cfg interface{}, key, value string) error {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if tag == key {
+			if v.Field(i).Kind() != reflect.String {
+				return fmt.Errorf("config key %s is not a string field", key)
+			}
+			v.Field(i).SetString(value)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown config key: %s", key)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"please/gallery"
+	"please/models"
+	"please/providers"
+)
+
+var modelCmd = &cobra.Command{
+	Use:   "model",
+	Short: "Inspect and manage the models in please's gallery",
+}
+
+var modelListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the model profiles in the gallery",
+	RunE: func(c *cobra.Command, args []string) error {
+		g, err := gallery.Load()
+		if err != nil {
+			return err
+		}
+		for _, p := range g.Profiles {
+			fmt.Printf("%s\t%s/%s\t%v\n", p.Name, p.Provider, p.Model, p.TaskTags)
+		}
+		return nil
+	},
+}
+
+var modelPullCmd = &cobra.Command{
+	Use:   "pull <name>",
+	Short: "Pull a gallery profile's Ollama model",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		g, err := gallery.Load()
+		if err != nil {
+			return err
+		}
+		profile, ok := g.Find(args[0])
+		if !ok {
+			return fmt.Errorf("no gallery profile named %q", args[0])
+		}
+		if profile.Provider != "ollama" {
+			return fmt.Errorf("profile %q uses provider %q, not ollama; nothing to pull", profile.Name, profile.Provider)
+		}
+
+		cfg := loadRunConfig()
+		provider := providers.NewOllamaProvider(cfg)
+		return provider.Pull(profile.Model, func(status string, completed, total int64) {
+			if total > 0 {
+				fmt.Printf("\r%s: %s (%d/%d)", profile.Model, status, completed, total)
+			} else {
+				fmt.Printf("\r%s: %s", profile.Model, status)
+			}
+		})
+	},
+}
+
+var modelTestCmd = &cobra.Command{
+	Use:   "test <name> <task description>",
+	Short: "Generate a script with one gallery profile to sanity-check it",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(c *cobra.Command, args []string) error {
+		g, err := gallery.Load()
+		if err != nil {
+			return err
+		}
+		profile, ok := g.Find(args[0])
+		if !ok {
+			return fmt.Errorf("no gallery profile named %q", args[0])
+		}
+
+		flagProvider = profile.Provider
+		flagModel = profile.Model
+
+		taskDescription := strings.Join(args[1:], " ")
+		tags := models.ClassifyTask(taskDescription)
+		fmt.Printf("task tags: %v\n", tags)
+
+		return runGenerate(taskDescription)
+	},
+}
+
+func init() {
+	modelCmd.AddCommand(modelListCmd)
+	modelCmd.AddCommand(modelPullCmd)
+	modelCmd.AddCommand(modelTestCmd)
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"please/providers"
+)
+
+var builtinProviders = []string{"ollama", "openai", "anthropic"}
+
+var providerCmd = &cobra.Command{
+	Use:   "provider",
+	Short: "Manage LLM provider plugins",
+}
+
+var providerListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List built-in providers and discovered provider plugins",
+	RunE: func(c *cobra.Command, args []string) error {
+		fmt.Println("built-in:")
+		for _, name := range builtinProviders {
+			fmt.Printf("  %s\n", name)
+		}
+
+		plugins := providers.DiscoverPlugins()
+		fmt.Println("plugins:")
+		if len(plugins) == 0 {
+			fmt.Println("  (none found)")
+			return nil
+		}
+		for _, name := range plugins {
+			fmt.Printf("  %s\n", describePlugin(name))
+		}
+		return nil
+	},
+}
+
+var providerInstallCmd = &cobra.Command{
+	Use:   "install <path-to-binary>",
+	Short: "Copy a please-provider-* binary into ~/.config/please/plugins",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		src := args[0]
+		name := filepath.Base(src)
+
+		bareName := strings.TrimSuffix(name, ".exe")
+		if !strings.HasPrefix(bareName, providers.PluginNamePrefix) {
+			return fmt.Errorf("%s must start with %q so please can discover it (rename to %s%s)", name, providers.PluginNamePrefix, providers.PluginNamePrefix, name)
+		}
+
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return err
+		}
+		dir := filepath.Join(home, ".config", "please", "plugins")
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+
+		if err := copyExecutable(src, filepath.Join(dir, name)); err != nil {
+			return err
+		}
+
+		fmt.Printf("installed %s\n", name)
+		return nil
+	},
+}
+
+func init() {
+	providerCmd.AddCommand(providerListCmd)
+	providerCmd.AddCommand(providerInstallCmd)
+}
+
+// describePlugin launches the please-provider-<name> plugin just long
+// enough to ask it to Describe itself, so "pls provider list" can show a
+// version alongside the name. It falls back to the bare name if the
+// plugin can't be started or doesn't answer.
+func describePlugin(name string) string {
+	provider, cleanup, err := providers.LoadPlugin(name)
+	if err != nil {
+		return name
+	}
+	defer cleanup()
+
+	describer, ok := provider.(providers.Describer)
+	if !ok {
+		return name
+	}
+	desc, err := describer.Describe()
+	if err != nil {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, desc.Version)
+}
+
+func copyExecutable(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,293 @@
+// Package cmd wires up please's command-line surface with Cobra. The root
+// command's default action is the script generator; everything else (alias
+// management, config, models) hangs off it as real subcommands.
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"please/config"
+	"please/models"
+	"please/providers"
+	"please/types"
+	"please/ui"
+)
+
+var (
+	flagProvider   string
+	flagModel      string
+	flagScriptType string
+
+	flagOutput string
+	flagYes    bool
+	flagStdin  bool
+)
+
+const (
+	outputPretty = "pretty"
+	outputRaw    = "raw"
+	outputJSON   = "json"
+)
+
+var rootCmd = &cobra.Command{
+	Use:           "pls [task description]",
+	Short:         "Turn natural language into shell scripts",
+	Long:          "please (pls) asks an LLM to turn a natural-language task description into a ready-to-run shell script.",
+	Version:       ui.Version,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Args:          cobra.ArbitraryArgs,
+	RunE: func(c *cobra.Command, args []string) error {
+		switch flagOutput {
+		case outputPretty, outputRaw, outputJSON:
+		default:
+			return fmt.Errorf("invalid --output %q: must be one of pretty, raw, json", flagOutput)
+		}
+
+		taskDescription := strings.Join(args, " ")
+		if flagStdin {
+			data, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				return fmt.Errorf("failed to read task from stdin: %w", err)
+			}
+			taskDescription = strings.TrimSpace(string(data))
+		}
+
+		if taskDescription == "" {
+			ui.ShowMainMenu()
+			return nil
+		}
+		return runGenerate(taskDescription)
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&flagProvider, "provider", "", "override the configured provider for this run")
+	rootCmd.PersistentFlags().StringVar(&flagModel, "model", "", "override the selected model for this run")
+	rootCmd.PersistentFlags().StringVar(&flagScriptType, "script-type", "", "override the generated script's shell/language for this run")
+
+	rootCmd.Flags().StringVar(&flagOutput, "output", defaultOutputMode(), "output mode: pretty, raw, or json")
+	rootCmd.Flags().BoolVar(&flagYes, "yes", false, "auto-confirm and skip the interactive menu")
+	rootCmd.Flags().BoolVar(&flagStdin, "stdin", false, "read the task description from stdin instead of the arguments")
+
+	rootCmd.AddCommand(aliasCmd)
+	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(modelCmd)
+	rootCmd.AddCommand(providerCmd)
+	rootCmd.AddCommand(versionCmd)
+}
+
+// Execute runs the root command, exiting the process on error.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// loadRunConfig loads the saved config and applies a --model override for
+// this invocation only; it never persists it. --provider and --script-type
+// are handled separately by DetermineProvider/DetermineScriptType, which
+// also need to arbitrate against PLEASE_PROVIDER/PLEASE_SCRIPT_TYPE.
+func loadRunConfig() *types.Config {
+	cfg, err := config.Load()
+	if err != nil {
+		cfg = config.CreateDefault()
+		config.Save(cfg) // Ignore errors for config saving
+	}
+
+	if flagModel != "" {
+		cfg.Model = flagModel
+	}
+	return cfg
+}
+
+// runGenerate is the default root action: classify the task, pick a model
+// and provider, generate a script, and show it to the user.
+func runGenerate(taskDescription string) error {
+	cfg := loadRunConfig()
+
+	// --script-type/--provider win over everything, including the
+	// PLEASE_SCRIPT_TYPE/PLEASE_PROVIDER env vars, since they were asked for
+	// on this exact invocation.
+	scriptType := config.DetermineScriptType(cfg, flagScriptType)
+	provider := config.DetermineProvider(cfg, flagProvider)
+
+	model := ""
+	profile, err := models.SelectBestModel(cfg, taskDescription, provider)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Could not auto-select model (%v), using fallback\n", err)
+		model = getFallbackModel(provider)
+	} else {
+		model = profile.Model
+		if profile.Pull && profile.Provider == "ollama" {
+			if err := ensureModelPulled(cfg, profile.Model); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: could not pull model %s (%v), trying anyway\n", profile.Model, err)
+			}
+		}
+	}
+
+	request := &types.ScriptRequest{
+		TaskDescription: taskDescription,
+		ScriptType:      scriptType,
+		Provider:        provider,
+		Model:           model,
+	}
+
+	response, err := generateScript(cfg, request)
+	if err != nil {
+		return err
+	}
+
+	displayScriptAndConfirm(response)
+	return nil
+}
+
+// ensureModelPulled pulls model via Ollama if it isn't already present,
+// printing streamed progress to stderr so it doesn't interleave with
+// --output raw/json on stdout.
+func ensureModelPulled(cfg *types.Config, model string) error {
+	provider := providers.NewOllamaProvider(cfg)
+	return provider.EnsurePulled(model, func(status string, completed, total int64) {
+		if total > 0 {
+			fmt.Fprintf(os.Stderr, "\rpulling %s: %s (%d/%d)", model, status, completed, total)
+		} else {
+			fmt.Fprintf(os.Stderr, "\rpulling %s: %s", model, status)
+		}
+	})
+}
+
+// generateScript creates a script using the appropriate provider, falling
+// back to a discovered please-provider-* plugin for anything that isn't
+// built in.
+func generateScript(cfg *types.Config, request *types.ScriptRequest) (*types.ScriptResponse, error) {
+	provider, cleanup, err := resolveProvider(cfg, request.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if cleanup != nil {
+		defer cleanup()
+	}
+
+	if !provider.IsConfigured(cfg) {
+		return nil, fmt.Errorf("provider %s is not properly configured", request.Provider)
+	}
+
+	if streaming, ok := provider.(providers.StreamingProvider); ok {
+		return streaming.StreamTokens(request, func(token string) {
+			fmt.Fprint(os.Stderr, token)
+		})
+	}
+
+	return provider.GenerateScript(request)
+}
+
+// resolveProvider returns the built-in provider for name, or launches the
+// matching please-provider-<name> plugin if name isn't built in.
+func resolveProvider(cfg *types.Config, name string) (providers.Provider, func(), error) {
+	switch name {
+	case "ollama":
+		return providers.NewOllamaProvider(cfg), nil, nil
+	case "openai":
+		return providers.NewOpenAIProvider(cfg), nil, nil
+	case "anthropic":
+		return providers.NewAnthropicProvider(cfg), nil, nil
+	}
+
+	provider, cleanup, err := providers.LoadPlugin(name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unsupported provider: %s (%w)", name, err)
+	}
+	return provider, cleanup, nil
+}
+
+// getFallbackModel returns a fallback model based on provider.
+func getFallbackModel(provider string) string {
+	switch provider {
+	case "openai":
+		return "gpt-3.5-turbo"
+	case "anthropic":
+		return "claude-3-haiku-20240307"
+	default:
+		return "llama3.2"
+	}
+}
+
+// defaultOutputMode defaults to raw when stdout isn't a terminal (piped
+// into another command, redirected to a file, under CI, ...), and pretty
+// otherwise.
+func defaultOutputMode() string {
+	if info, err := os.Stdout.Stat(); err == nil && info.Mode()&os.ModeCharDevice == 0 {
+		return outputRaw
+	}
+	return outputPretty
+}
+
+// displayScriptAndConfirm renders response according to --output, then runs
+// the interactive menu unless --yes or a non-pretty mode skips it.
+func displayScriptAndConfirm(response *types.ScriptResponse) {
+	switch flagOutput {
+	case outputJSON:
+		displayJSON(response)
+	case outputRaw:
+		displayRaw(response)
+	default:
+		displayPretty(response)
+	}
+
+	if flagYes || flagOutput != outputPretty {
+		return
+	}
+	ui.ShowScriptMenu(response)
+}
+
+// displayRaw writes only the script body to stdout, so callers can safely
+// do eval "$(pls --output raw --yes '...')"; everything else goes to
+// stderr.
+func displayRaw(response *types.ScriptResponse) {
+	fmt.Fprintln(os.Stdout, response.Script)
+
+	fmt.Fprintf(os.Stderr, "# task: %s\n", response.TaskDescription)
+	fmt.Fprintf(os.Stderr, "# model: %s (%s)\n", response.Model, response.Provider)
+	for _, w := range response.Warnings {
+		fmt.Fprintf(os.Stderr, "# warning: %s\n", w)
+	}
+}
+
+// displayJSON writes the full ScriptResponse as a single JSON object to
+// stdout, for callers like pls serve or editor integrations.
+func displayJSON(response *types.ScriptResponse) {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(response); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to encode response: %v\n", err)
+	}
+}
+
+func displayPretty(response *types.ScriptResponse) {
+	fmt.Printf("╔══════════════════════════════════════════════════════════════════════════════╗\n")
+	fmt.Printf("║                           🤖 Please Script Generator                         ║\n")
+	fmt.Printf("╚══════════════════════════════════════════════════════════════════════════════╝\n\n")
+
+	fmt.Printf("📝 Task: %s\n", response.TaskDescription)
+	fmt.Printf("🧠 Model: %s (%s)\n", response.Model, response.Provider)
+	fmt.Printf("🖥️  Platform: %s script\n", response.ScriptType)
+
+	fmt.Printf("\n╔══════════════════════════════════════════════════════════════════════════════╗\n")
+	fmt.Printf("║                              📋 Generated Script                             ║\n")
+	fmt.Printf("╚══════════════════════════════════════════════════════════════════════════════╝\n\n")
+
+	lines := strings.Split(response.Script, "\n")
+	for i, line := range lines {
+		lineNum := fmt.Sprintf("%3d", i+1)
+		fmt.Printf("\033[90m%s│\033[0m %s\n", lineNum, line)
+	}
+
+	fmt.Printf("\n✅ Script generated successfully!\n")
+}
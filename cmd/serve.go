@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"please/server"
+	"please/types"
+)
+
+var (
+	flagServeListen    string
+	flagServeAPIKey    string
+	flagServeProviders string
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Expose an OpenAI-compatible HTTP API backed by please",
+	RunE: func(c *cobra.Command, args []string) error {
+		cfg := loadRunConfig()
+
+		var providerAllowlist []string
+		if flagServeProviders != "" {
+			providerAllowlist = strings.Split(flagServeProviders, ",")
+		}
+
+		srv := server.New(server.Options{
+			Addr:      flagServeListen,
+			APIKey:    flagServeAPIKey,
+			Providers: providerAllowlist,
+			Generate: func(request *types.ScriptRequest) (*types.ScriptResponse, error) {
+				return generateScript(cfg, request)
+			},
+		})
+
+		fmt.Printf("please serve listening on %s\n", flagServeListen)
+		return srv.ListenAndServe()
+	},
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&flagServeListen, "listen", "127.0.0.1:8080", "address to listen on")
+	serveCmd.Flags().StringVar(&flagServeAPIKey, "api-key", "", "require this bearer token on incoming requests")
+	serveCmd.Flags().StringVar(&flagServeProviders, "providers", "", "comma-separated allowlist of providers to serve (default: all configured)")
+
+	rootCmd.AddCommand(serveCmd)
+}
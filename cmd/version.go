@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"please/ui"
+)
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print please's version",
+	RunE: func(c *cobra.Command, args []string) error {
+		ui.ShowVersion()
+		return nil
+	},
+}
@@ -0,0 +1,124 @@
+// Package config loads and saves please's user configuration and derives
+// run-time settings (script type, provider) from it and the environment.
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"please/types"
+)
+
+// Dir returns the directory please stores its configuration in,
+// ~/.config/please.
+func Dir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "please"), nil
+}
+
+// Path returns the path to the config file itself.
+func Path() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "config.json"), nil
+}
+
+// Load reads the config file from disk.
+func Load() (*types.Config, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg types.Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// CreateDefault builds a sensible default configuration for a fresh install.
+// Model is deliberately left empty so models.SelectBestModel keeps scoring
+// the gallery against each task instead of being pinned forever after the
+// first run.
+func CreateDefault() *types.Config {
+	return &types.Config{
+		Provider:   "ollama",
+		ScriptType: defaultScriptType(),
+		OllamaURL:  "http://localhost:11434",
+	}
+}
+
+// Save writes cfg to disk, creating the config directory if needed.
+func Save(cfg *types.Config) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// DetermineScriptType returns the shell script dialect to generate for.
+// override (typically a --script-type flag) wins outright; otherwise
+// PLEASE_SCRIPT_TYPE is honored before falling back to cfg and the OS
+// default.
+func DetermineScriptType(cfg *types.Config, override string) string {
+	if override != "" {
+		return override
+	}
+	if v := os.Getenv("PLEASE_SCRIPT_TYPE"); v != "" {
+		return v
+	}
+	if cfg.ScriptType != "" {
+		return cfg.ScriptType
+	}
+	return defaultScriptType()
+}
+
+// DetermineProvider returns which LLM provider to use. override (typically
+// a --provider flag) wins outright; otherwise PLEASE_PROVIDER is honored
+// before falling back to cfg.
+func DetermineProvider(cfg *types.Config, override string) string {
+	if override != "" {
+		return override
+	}
+	if v := os.Getenv("PLEASE_PROVIDER"); v != "" {
+		return v
+	}
+	if cfg.Provider != "" {
+		return cfg.Provider
+	}
+	return "ollama"
+}
+
+func defaultScriptType() string {
+	if runtime.GOOS == "windows" {
+		return "powershell"
+	}
+	return "bash"
+}
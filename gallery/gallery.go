@@ -0,0 +1,170 @@
+// Package gallery loads named model profiles from YAML and scores them
+// against a classified task, so model selection no longer has to hardcode
+// one model name per provider.
+package gallery
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Profile describes one selectable model: which provider/model backs it,
+// what kinds of tasks it's good for, and how to talk to it.
+type Profile struct {
+	Name                 string   `yaml:"name"`
+	Provider             string   `yaml:"provider"`
+	Model                string   `yaml:"model"`
+	TaskTags             []string `yaml:"task_tags"`
+	ContextWindow        int      `yaml:"context_window"`
+	Temperature          float64  `yaml:"temperature"`
+	SystemPromptTemplate string   `yaml:"system_prompt_template"`
+	Pull                 bool     `yaml:"pull"`
+}
+
+// Gallery is the merged set of shipped-default and user-defined profiles.
+type Gallery struct {
+	Profiles []Profile `yaml:"profiles"`
+}
+
+// defaultProfiles ships with please so model selection works before a user
+// ever writes their own gallery.yaml.
+func defaultProfiles() []Profile {
+	return []Profile{
+		{
+			Name:          "llama-bash",
+			Provider:      "ollama",
+			Model:         "llama3.2",
+			TaskTags:      []string{"bash", "general"},
+			ContextWindow: 8192,
+			Temperature:   0.2,
+			Pull:          true,
+		},
+		{
+			Name:          "llama-powershell",
+			Provider:      "ollama",
+			Model:         "llama3.2",
+			TaskTags:      []string{"powershell", "general"},
+			ContextWindow: 8192,
+			Temperature:   0.2,
+			Pull:          true,
+		},
+		{
+			Name:          "gpt-refactor",
+			Provider:      "openai",
+			Model:         "gpt-4o-mini",
+			TaskTags:      []string{"refactor", "long-context"},
+			ContextWindow: 128000,
+			Temperature:   0.2,
+		},
+		{
+			Name:          "claude-refactor",
+			Provider:      "anthropic",
+			Model:         "claude-3-5-sonnet-20241022",
+			TaskTags:      []string{"refactor", "long-context"},
+			ContextWindow: 200000,
+			Temperature:   0.2,
+		},
+	}
+}
+
+// UserPath returns the path to the user's override gallery file,
+// ~/.config/please/gallery.yaml.
+func UserPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "please", "gallery.yaml"), nil
+}
+
+// Load returns the shipped defaults merged with the user's gallery.yaml, if
+// one exists. A profile in the user file with the same Name as a default
+// replaces it.
+func Load() (*Gallery, error) {
+	g := &Gallery{Profiles: defaultProfiles()}
+
+	path, err := UserPath()
+	if err != nil {
+		return g, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return g, nil
+		}
+		return nil, err
+	}
+
+	var userGallery Gallery
+	if err := yaml.Unmarshal(data, &userGallery); err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]int, len(g.Profiles))
+	for i, p := range g.Profiles {
+		byName[p.Name] = i
+	}
+	for _, p := range userGallery.Profiles {
+		if i, ok := byName[p.Name]; ok {
+			g.Profiles[i] = p
+			continue
+		}
+		g.Profiles = append(g.Profiles, p)
+	}
+
+	return g, nil
+}
+
+// Find returns the profile with the given name, if any.
+func (g *Gallery) Find(name string) (Profile, bool) {
+	for _, p := range g.Profiles {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Profile{}, false
+}
+
+// Score returns how well a profile matches a task's tags and the requested
+// provider. Higher is better; providerMatch profiles are strongly
+// preferred since generating against an unconfigured provider is useless.
+func (p Profile) Score(taskTags []string, provider string) int {
+	score := 0
+	if provider != "" && p.Provider == provider {
+		score += 10
+	}
+
+	tagSet := make(map[string]struct{}, len(taskTags))
+	for _, t := range taskTags {
+		tagSet[strings.ToLower(t)] = struct{}{}
+	}
+	for _, t := range p.TaskTags {
+		if _, ok := tagSet[strings.ToLower(t)]; ok {
+			score++
+		}
+	}
+	return score
+}
+
+// Best returns the highest-scoring profile for taskTags and provider. If
+// provider is empty, every profile is considered regardless of provider.
+func (g *Gallery) Best(taskTags []string, provider string) (Profile, bool) {
+	var best Profile
+	bestScore := -1
+	found := false
+
+	for _, p := range g.Profiles {
+		if provider != "" && p.Provider != provider {
+			continue
+		}
+		score := p.Score(taskTags, provider)
+		if score > bestScore {
+			best, bestScore, found = p, score, true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,124 @@
+package gallery
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfileScorePrefersMatchingProvider(t *testing.T) {
+	profile := Profile{Provider: "ollama", TaskTags: []string{"bash"}}
+
+	withProvider := profile.Score([]string{"bash"}, "ollama")
+	withoutProvider := profile.Score([]string{"bash"}, "openai")
+
+	if withProvider <= withoutProvider {
+		t.Fatalf("expected matching provider to score higher: with=%d without=%d", withProvider, withoutProvider)
+	}
+}
+
+func TestProfileScoreCountsMatchingTags(t *testing.T) {
+	profile := Profile{TaskTags: []string{"bash", "refactor"}}
+
+	oneTag := profile.Score([]string{"bash"}, "")
+	twoTags := profile.Score([]string{"bash", "refactor"}, "")
+
+	if twoTags <= oneTag {
+		t.Fatalf("expected more matching tags to score higher: one=%d two=%d", oneTag, twoTags)
+	}
+}
+
+func TestProfileScoreIsCaseInsensitive(t *testing.T) {
+	profile := Profile{TaskTags: []string{"Bash"}}
+
+	if got := profile.Score([]string{"bash"}, ""); got == 0 {
+		t.Fatalf("expected tag matching to ignore case, got score %d", got)
+	}
+}
+
+func TestGalleryBestPicksHighestScoringProfileForProvider(t *testing.T) {
+	g := &Gallery{Profiles: []Profile{
+		{Name: "ollama-general", Provider: "ollama", TaskTags: []string{"general"}},
+		{Name: "gpt-refactor", Provider: "openai", TaskTags: []string{"refactor", "long-context"}},
+		{Name: "claude-refactor", Provider: "anthropic", TaskTags: []string{"refactor", "long-context"}},
+	}}
+
+	best, ok := g.Best([]string{"refactor", "long-context"}, "anthropic")
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if best.Name != "claude-refactor" {
+		t.Fatalf("expected claude-refactor, got %s", best.Name)
+	}
+}
+
+func TestGalleryBestWithoutProviderConsidersEveryProfile(t *testing.T) {
+	g := &Gallery{Profiles: []Profile{
+		{Name: "ollama-general", Provider: "ollama", TaskTags: []string{"general"}},
+		{Name: "gpt-refactor", Provider: "openai", TaskTags: []string{"refactor"}},
+	}}
+
+	best, ok := g.Best([]string{"refactor"}, "")
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if best.Name != "gpt-refactor" {
+		t.Fatalf("expected gpt-refactor, got %s", best.Name)
+	}
+}
+
+func TestGalleryBestReturnsFalseWhenNoProfileMatchesProvider(t *testing.T) {
+	g := &Gallery{Profiles: []Profile{
+		{Name: "ollama-general", Provider: "ollama", TaskTags: []string{"general"}},
+	}}
+
+	if _, ok := g.Best([]string{"general"}, "openai"); ok {
+		t.Fatal("expected no match for a provider with no profiles")
+	}
+}
+
+func TestGalleryFind(t *testing.T) {
+	g := &Gallery{Profiles: []Profile{{Name: "llama-bash"}}}
+
+	if _, ok := g.Find("llama-bash"); !ok {
+		t.Fatal("expected to find llama-bash")
+	}
+	if _, ok := g.Find("does-not-exist"); ok {
+		t.Fatal("expected not to find an unknown profile")
+	}
+}
+
+func TestLoadMergesUserProfilesByName(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	dir := filepath.Join(home, ".config", "please")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	userYAML := "profiles:\n" +
+		"  - name: llama-bash\n    provider: ollama\n    model: custom-model\n" +
+		"  - name: my-profile\n    provider: openai\n    model: gpt-4o\n"
+	if err := os.WriteFile(filepath.Join(dir, "gallery.yaml"), []byte(userYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	bash, ok := g.Find("llama-bash")
+	if !ok {
+		t.Fatal("expected shipped default profile llama-bash to be present")
+	}
+	if bash.Model != "custom-model" {
+		t.Fatalf("expected user profile to replace default llama-bash, got model %q", bash.Model)
+	}
+	if _, ok := g.Find("my-profile"); !ok {
+		t.Fatal("expected new user profile my-profile to be appended")
+	}
+	if _, ok := g.Find("llama-powershell"); !ok {
+		t.Fatal("expected untouched default profile llama-powershell to be kept")
+	}
+}
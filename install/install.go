@@ -0,0 +1,287 @@
+// Package install manages the "pls" shortcut across POSIX shells and
+// Windows, in place of the Windows-only .bat files please used to ship.
+package install
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const (
+	markerBegin = "# >>> please alias >>>"
+	markerEnd   = "# <<< please alias <<<"
+)
+
+// Result summarizes what Install or Uninstall did, for the caller to print.
+type Result struct {
+	Steps []string
+}
+
+func (r *Result) note(format string, args ...interface{}) {
+	r.Steps = append(r.Steps, fmt.Sprintf(format, args...))
+}
+
+// Install sets up the "pls" shortcut for the current platform and shell,
+// pointing at execPath.
+func Install(execPath string) (*Result, error) {
+	r := &Result{}
+
+	if runtime.GOOS == "windows" {
+		return r, installWindows(execPath, r)
+	}
+	return r, installPOSIX(execPath, r)
+}
+
+// Uninstall idempotently reverses everything Install may have done.
+func Uninstall(execPath string) (*Result, error) {
+	r := &Result{}
+
+	if runtime.GOOS == "windows" {
+		return r, uninstallWindows(execPath, r)
+	}
+	return r, uninstallPOSIX(execPath, r)
+}
+
+func installPOSIX(execPath string, r *Result) error {
+	binDir, err := localBinDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", binDir, err)
+	}
+
+	for _, name := range []string{"pls", "ol"} {
+		link := filepath.Join(binDir, name)
+		os.Remove(link) // Ignore errors; link may not exist yet.
+		if err := os.Symlink(execPath, link); err != nil {
+			return fmt.Errorf("failed to symlink %s: %w", link, err)
+		}
+		r.note("symlinked %s -> %s", link, execPath)
+	}
+
+	for _, rc := range shellRCFiles() {
+		if err := addRCBlock(rc, binDir); err != nil {
+			return fmt.Errorf("failed to update %s: %w", rc, err)
+		}
+		r.note("added PATH block to %s", rc)
+	}
+
+	return nil
+}
+
+func uninstallPOSIX(execPath string, r *Result) error {
+	binDir, err := localBinDir()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{"pls", "ol"} {
+		link := filepath.Join(binDir, name)
+		if err := os.Remove(link); err != nil {
+			if !os.IsNotExist(err) {
+				return fmt.Errorf("failed to remove %s: %w", link, err)
+			}
+			r.note("%s not found", link)
+			continue
+		}
+		r.note("removed %s", link)
+	}
+
+	for _, rc := range shellRCFiles() {
+		removed, err := removeRCBlock(rc)
+		if err != nil {
+			return fmt.Errorf("failed to update %s: %w", rc, err)
+		}
+		if removed {
+			r.note("removed PATH block from %s", rc)
+		}
+	}
+
+	return nil
+}
+
+// localBinDir is where the pls/ol symlinks are created: ~/.local/bin.
+func localBinDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".local", "bin"), nil
+}
+
+// shellRCFiles returns the rc files for every shell we know how to wire up,
+// regardless of which one is currently active, skipping those that don't
+// exist.
+func shellRCFiles() []string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+
+	candidates := []string{
+		filepath.Join(home, ".bashrc"),
+		filepath.Join(home, ".zshrc"),
+		filepath.Join(home, ".config", "fish", "config.fish"),
+	}
+
+	var existing []string
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			existing = append(existing, path)
+		}
+	}
+	return existing
+}
+
+// addRCBlock appends a marker-delimited PATH export for binDir to path,
+// replacing any block already added by a previous install.
+func addRCBlock(path, binDir string) error {
+	if _, err := removeRCBlock(path); err != nil {
+		return err
+	}
+
+	block := rcBlock(path, binDir)
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString("\n" + block + "\n")
+	return err
+}
+
+// removeRCBlock strips a marker-delimited block from path, if present. It
+// reports whether it found and removed one.
+func removeRCBlock(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	var out []string
+	inBlock := false
+	removed := false
+
+	for _, line := range lines {
+		switch {
+		case strings.TrimSpace(line) == markerBegin:
+			inBlock, removed = true, true
+			continue
+		case strings.TrimSpace(line) == markerEnd:
+			inBlock = false
+			continue
+		case inBlock:
+			continue
+		default:
+			out = append(out, line)
+		}
+	}
+
+	if !removed {
+		return false, nil
+	}
+
+	return true, os.WriteFile(path, []byte(strings.TrimRight(strings.Join(out, "\n"), "\n")+"\n"), 0o644)
+}
+
+func rcBlock(rcPath, binDir string) string {
+	switch {
+	case strings.HasSuffix(rcPath, "config.fish"):
+		return fmt.Sprintf("%s\nfish_add_path %s\n%s", markerBegin, binDir, markerEnd)
+	case strings.HasSuffix(rcPath, ".ps1"):
+		return fmt.Sprintf("%s\n$env:Path = \"%s;$env:Path\"\n%s", markerBegin, binDir, markerEnd)
+	default:
+		return fmt.Sprintf("%s\nexport PATH=\"%s:$PATH\"\n%s", markerBegin, binDir, markerEnd)
+	}
+}
+
+// windowsPLSDir is where pls.ps1 lives and the directory the PowerShell
+// profile block adds to PATH: %USERPROFILE%\please\bin.
+func windowsPLSDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, "please", "bin"), nil
+}
+
+func installWindows(execPath string, r *Result) error {
+	dir, err := windowsPLSDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	ps1Path := filepath.Join(dir, "pls.ps1")
+	ps1Content := fmt.Sprintf("& \"%s\" @Args\n", execPath)
+	if err := os.WriteFile(ps1Path, []byte(ps1Content), 0o755); err != nil {
+		return fmt.Errorf("failed to write %s: %w", ps1Path, err)
+	}
+	r.note("wrote %s", ps1Path)
+
+	profilePath, err := windowsProfilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(profilePath), 0o755); err != nil {
+		return err
+	}
+	if err := addRCBlock(profilePath, dir); err != nil {
+		return fmt.Errorf("failed to update %s: %w", profilePath, err)
+	}
+	r.note("added PATH block to %s", profilePath)
+
+	return nil
+}
+
+func uninstallWindows(execPath string, r *Result) error {
+	dir, err := windowsPLSDir()
+	if err != nil {
+		return err
+	}
+
+	ps1Path := filepath.Join(dir, "pls.ps1")
+	if err := os.Remove(ps1Path); err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove %s: %w", ps1Path, err)
+		}
+		r.note("%s not found", ps1Path)
+	} else {
+		r.note("removed %s", ps1Path)
+	}
+
+	profilePath, err := windowsProfilePath()
+	if err != nil {
+		return err
+	}
+	removed, err := removeRCBlock(profilePath)
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", profilePath, err)
+	}
+	if removed {
+		r.note("removed PATH block from %s", profilePath)
+	}
+
+	return nil
+}
+
+// windowsProfilePath returns the current user's PowerShell profile path.
+func windowsProfilePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, "Documents", "WindowsPowerShell", "Microsoft.PowerShell_profile.ps1"), nil
+}
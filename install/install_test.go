@@ -0,0 +1,121 @@
+package install
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAddRCBlockIsIdempotent(t *testing.T) {
+	dir := t.TempDir()
+	rc := filepath.Join(dir, ".bashrc")
+	if err := os.WriteFile(rc, []byte("# existing user content\nexport FOO=bar\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := addRCBlock(rc, "/home/user/.local/bin"); err != nil {
+		t.Fatalf("first addRCBlock: %v", err)
+	}
+	first, err := os.ReadFile(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := addRCBlock(rc, "/home/user/.local/bin"); err != nil {
+		t.Fatalf("second addRCBlock: %v", err)
+	}
+	second, err := os.ReadFile(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(first) != string(second) {
+		t.Fatalf("addRCBlock is not idempotent:\nfirst:\n%s\nsecond:\n%s", first, second)
+	}
+	if strings.Count(string(second), markerBegin) != 1 {
+		t.Fatalf("expected exactly one marker block, got:\n%s", second)
+	}
+	if !strings.Contains(string(second), "# existing user content") {
+		t.Fatalf("addRCBlock must not disturb existing content:\n%s", second)
+	}
+}
+
+func TestRemoveRCBlockReversesAdd(t *testing.T) {
+	dir := t.TempDir()
+	rc := filepath.Join(dir, ".zshrc")
+	original := "# existing user content\nexport FOO=bar\n"
+	if err := os.WriteFile(rc, []byte(original), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := addRCBlock(rc, "/home/user/.local/bin"); err != nil {
+		t.Fatalf("addRCBlock: %v", err)
+	}
+
+	removed, err := removeRCBlock(rc)
+	if err != nil {
+		t.Fatalf("removeRCBlock: %v", err)
+	}
+	if !removed {
+		t.Fatal("removeRCBlock reported nothing removed")
+	}
+
+	data, err := os.ReadFile(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != original {
+		t.Fatalf("removeRCBlock did not restore original content: got %q, want %q", data, original)
+	}
+}
+
+func TestRemoveRCBlockOnFileWithoutMarkerIsNoop(t *testing.T) {
+	dir := t.TempDir()
+	rc := filepath.Join(dir, ".bashrc")
+	if err := os.WriteFile(rc, []byte("export FOO=bar\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := removeRCBlock(rc)
+	if err != nil {
+		t.Fatalf("removeRCBlock: %v", err)
+	}
+	if removed {
+		t.Fatal("removeRCBlock reported a removal where there was no marker block")
+	}
+}
+
+func TestRemoveRCBlockOnMissingFileIsNoop(t *testing.T) {
+	removed, err := removeRCBlock(filepath.Join(t.TempDir(), "does-not-exist"))
+	if err != nil {
+		t.Fatalf("removeRCBlock on a missing file should not error: %v", err)
+	}
+	if removed {
+		t.Fatal("removeRCBlock reported a removal for a file that doesn't exist")
+	}
+}
+
+func TestRCBlockUsesFishSyntaxForFishConfig(t *testing.T) {
+	block := rcBlock("/home/user/.config/fish/config.fish", "/home/user/.local/bin")
+	if !strings.Contains(block, "fish_add_path") {
+		t.Fatalf("expected fish_add_path in fish config block, got: %s", block)
+	}
+}
+
+func TestRCBlockUsesPOSIXExportForBash(t *testing.T) {
+	block := rcBlock("/home/user/.bashrc", "/home/user/.local/bin")
+	if !strings.Contains(block, `export PATH="/home/user/.local/bin:$PATH"`) {
+		t.Fatalf("expected export PATH in bash block, got: %s", block)
+	}
+}
+
+func TestRCBlockUsesPowerShellSyntaxForProfile(t *testing.T) {
+	block := rcBlock(`C:\Users\user\Documents\WindowsPowerShell\Microsoft.PowerShell_profile.ps1`, `C:\Users\user\please\bin`)
+	if !strings.Contains(block, `$env:Path = "C:\Users\user\please\bin;$env:Path"`) {
+		t.Fatalf("expected PowerShell $env:Path assignment in profile block, got: %s", block)
+	}
+	if strings.Contains(block, "export PATH") {
+		t.Fatalf("PowerShell profile block should not contain POSIX export syntax, got: %s", block)
+	}
+}
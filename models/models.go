@@ -0,0 +1,59 @@
+// Package models selects which LLM model please should use for a given task
+// and provider, scoring the task against the model gallery.
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"please/gallery"
+	"please/types"
+)
+
+// SelectBestModel picks a gallery profile for taskDescription given the
+// configured provider by scoring the gallery's profiles against the task's
+// classified tags. If cfg pins a model explicitly, that wins outright and
+// is returned as a synthetic profile with Pull left false, since an
+// explicit override shouldn't trigger an unrelated gallery auto-pull.
+func SelectBestModel(cfg *types.Config, taskDescription string, provider string) (gallery.Profile, error) {
+	if cfg.Model != "" {
+		return gallery.Profile{Name: cfg.Model, Provider: provider, Model: cfg.Model}, nil
+	}
+
+	g, err := gallery.Load()
+	if err != nil {
+		return gallery.Profile{}, fmt.Errorf("failed to load model gallery: %w", err)
+	}
+
+	tags := ClassifyTask(taskDescription)
+	profile, ok := g.Best(tags, provider)
+	if !ok {
+		return gallery.Profile{}, fmt.Errorf("no gallery profile matches provider %q", provider)
+	}
+
+	return profile, nil
+}
+
+// ClassifyTask derives coarse task_tags (bash, powershell, refactor,
+// long-context, ...) from a task description, for matching against gallery
+// profiles.
+func ClassifyTask(taskDescription string) []string {
+	lower := strings.ToLower(taskDescription)
+	tags := []string{"general"}
+
+	switch {
+	case strings.Contains(lower, "powershell"), strings.Contains(lower, ".ps1"):
+		tags = append(tags, "powershell")
+	default:
+		tags = append(tags, "bash")
+	}
+
+	if strings.Contains(lower, "refactor") || strings.Contains(lower, "rewrite") {
+		tags = append(tags, "refactor")
+	}
+	if len(taskDescription) > 500 {
+		tags = append(tags, "long-context")
+	}
+
+	return tags
+}
@@ -0,0 +1,51 @@
+// Command please-provider-example is a reference implementation of please's
+// provider plugin protocol. It doesn't call out to a real LLM; it echoes
+// the task description back as a comment so the plugin protocol itself can
+// be exercised end-to-end.
+package main
+
+import (
+	goplugin "github.com/hashicorp/go-plugin"
+
+	"please/providers/plugin"
+	"please/types"
+)
+
+type exampleProvider struct{}
+
+func (exampleProvider) GenerateScript(request *types.ScriptRequest) (*types.ScriptResponse, error) {
+	return &types.ScriptResponse{
+		TaskDescription: request.TaskDescription,
+		Model:           "example-1",
+		Provider:        "example",
+		ScriptType:      request.ScriptType,
+		Script:          "# requested: " + request.TaskDescription,
+		Explanation:     "generated by the please-provider-example reference plugin",
+	}, nil
+}
+
+func (e exampleProvider) StreamTokens(request *types.ScriptRequest, onToken func(token string)) (*types.ScriptResponse, error) {
+	resp, err := e.GenerateScript(request)
+	if err != nil {
+		return nil, err
+	}
+	if onToken != nil {
+		onToken(resp.Script)
+	}
+	return resp, nil
+}
+
+func (exampleProvider) IsConfigured() (bool, error) {
+	return true, nil
+}
+
+func (exampleProvider) Describe() (plugin.Description, error) {
+	return plugin.Description{Name: "example", Version: "0.1.0"}, nil
+}
+
+func main() {
+	goplugin.Serve(&goplugin.ServeConfig{
+		HandshakeConfig: plugin.Handshake,
+		Plugins:         plugin.Map(exampleProvider{}),
+	})
+}
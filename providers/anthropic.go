@@ -0,0 +1,97 @@
+package providers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"please/types"
+)
+
+const anthropicAPIVersion = "2023-06-01"
+
+// AnthropicProvider generates scripts using the Anthropic messages API.
+type AnthropicProvider struct {
+	cfg *types.Config
+}
+
+// NewAnthropicProvider builds an AnthropicProvider bound to cfg.
+func NewAnthropicProvider(cfg *types.Config) *AnthropicProvider {
+	return &AnthropicProvider{cfg: cfg}
+}
+
+// IsConfigured reports whether an Anthropic API key is set.
+func (p *AnthropicProvider) IsConfigured(cfg *types.Config) bool {
+	return cfg.AnthropicAPIKey != ""
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicMessagesRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	Messages  []anthropicMessage `json:"messages"`
+}
+
+type anthropicMessagesResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+}
+
+// GenerateScript asks Anthropic's messages API for a script.
+func (p *AnthropicProvider) GenerateScript(request *types.ScriptRequest) (*types.ScriptResponse, error) {
+	if p.cfg.AnthropicAPIKey == "" {
+		return nil, fmt.Errorf("anthropic provider is not configured: missing API key")
+	}
+
+	reqBody, err := json.Marshal(anthropicMessagesRequest{
+		Model:     request.Model,
+		MaxTokens: 4096,
+		Messages: []anthropicMessage{
+			{Role: "user", Content: buildPrompt(request)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode anthropic request: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, "https://api.anthropic.com/v1/messages", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", p.cfg.AnthropicAPIKey)
+	httpReq.Header.Set("anthropic-version", anthropicAPIVersion)
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach anthropic: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("anthropic returned status %d", resp.StatusCode)
+	}
+
+	var out anthropicMessagesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode anthropic response: %w", err)
+	}
+	if len(out.Content) == 0 {
+		return nil, fmt.Errorf("anthropic returned no content")
+	}
+
+	return &types.ScriptResponse{
+		TaskDescription: request.TaskDescription,
+		Model:           request.Model,
+		Provider:        "anthropic",
+		ScriptType:      request.ScriptType,
+		Script:          out.Content[0].Text,
+	}, nil
+}
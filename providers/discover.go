@@ -0,0 +1,136 @@
+package providers
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	goplugin "github.com/hashicorp/go-plugin"
+
+	"please/providers/plugin"
+	"please/types"
+)
+
+// PluginNamePrefix is the filename prefix DiscoverPlugins and
+// findPluginBinary require of a provider plugin binary.
+const PluginNamePrefix = "please-provider-"
+
+// pluginProvider adapts a discovered plugin.Provider to this package's
+// Provider interface so it can sit in the same registry as the built-ins.
+type pluginProvider struct {
+	client *goplugin.Client
+	impl   plugin.Provider
+}
+
+func (p *pluginProvider) GenerateScript(request *types.ScriptRequest) (*types.ScriptResponse, error) {
+	return p.impl.GenerateScript(request)
+}
+
+func (p *pluginProvider) IsConfigured(cfg *types.Config) bool {
+	ok, err := p.impl.IsConfigured()
+	return err == nil && ok
+}
+
+func (p *pluginProvider) StreamTokens(request *types.ScriptRequest, onToken func(token string)) (*types.ScriptResponse, error) {
+	return p.impl.StreamTokens(request, onToken)
+}
+
+func (p *pluginProvider) Describe() (plugin.Description, error) {
+	return p.impl.Describe()
+}
+
+// PluginDirs returns the directories please searches for
+// please-provider-* binaries: the user's plugins directory and every
+// directory on $PATH.
+func PluginDirs() []string {
+	var dirs []string
+
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, filepath.Join(home, ".config", "please", "plugins"))
+	}
+	dirs = append(dirs, filepath.SplitList(os.Getenv("PATH"))...)
+	return dirs
+}
+
+// DiscoverPlugins scans PluginDirs for please-provider-* binaries and
+// returns their names (e.g. "gemini" for "please-provider-gemini"), deduped
+// in discovery order.
+func DiscoverPlugins() []string {
+	seen := make(map[string]bool)
+	var names []string
+
+	for _, dir := range PluginDirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasPrefix(entry.Name(), PluginNamePrefix) {
+				continue
+			}
+			name := strings.TrimSuffix(strings.TrimPrefix(entry.Name(), PluginNamePrefix), ".exe")
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// findPluginBinary locates the please-provider-<name> executable for name.
+func findPluginBinary(name string) (string, error) {
+	binName := PluginNamePrefix + name
+	for _, dir := range PluginDirs() {
+		candidate := filepath.Join(dir, binName)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, nil
+		}
+		if info, err := os.Stat(candidate + ".exe"); err == nil && !info.IsDir() {
+			return candidate + ".exe", nil
+		}
+	}
+	return "", fmt.Errorf("no plugin binary found for provider %q (looked for %s in %v)", name, binName, PluginDirs())
+}
+
+// LoadPlugin launches the please-provider-<name> binary and returns a
+// Provider backed by it, plus a cleanup func that kills the plugin process;
+// callers should call cleanup when done with the provider.
+func LoadPlugin(name string) (Provider, func(), error) {
+	path, err := findPluginBinary(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := goplugin.NewClient(&goplugin.ClientConfig{
+		HandshakeConfig: plugin.Handshake,
+		Plugins:         plugin.Map(nil),
+		Cmd:             exec.Command(path),
+		AllowedProtocols: []goplugin.Protocol{
+			goplugin.ProtocolNetRPC,
+		},
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed to start plugin %s: %w", name, err)
+	}
+
+	raw, err := rpcClient.Dispense("provider")
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed to dispense provider from plugin %s: %w", name, err)
+	}
+
+	impl, ok := raw.(plugin.Provider)
+	if !ok {
+		client.Kill()
+		return nil, nil, fmt.Errorf("plugin %s does not implement the provider protocol", name)
+	}
+
+	return &pluginProvider{client: client, impl: impl}, client.Kill, nil
+}
@@ -0,0 +1,170 @@
+package providers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"please/types"
+)
+
+// OllamaProvider generates scripts using a local or remote Ollama server.
+type OllamaProvider struct {
+	cfg    *types.Config
+	client *http.Client
+	// pullClient is used for /api/pull, which can take far longer than a
+	// generate call while it streams down a multi-GB model. It has no
+	// timeout so Client.Timeout can't kill a real download mid-stream.
+	pullClient *http.Client
+}
+
+// NewOllamaProvider builds an OllamaProvider bound to cfg.
+func NewOllamaProvider(cfg *types.Config) *OllamaProvider {
+	return &OllamaProvider{
+		cfg:        cfg,
+		client:     &http.Client{Timeout: 60 * time.Second},
+		pullClient: &http.Client{},
+	}
+}
+
+// IsConfigured reports whether an Ollama URL is set.
+func (p *OllamaProvider) IsConfigured(cfg *types.Config) bool {
+	return cfg.OllamaURL != ""
+}
+
+type ollamaGenerateRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+}
+
+type ollamaGenerateResponse struct {
+	Response string `json:"response"`
+}
+
+// GenerateScript asks Ollama's /api/generate endpoint for a script.
+func (p *OllamaProvider) GenerateScript(request *types.ScriptRequest) (*types.ScriptResponse, error) {
+	reqBody, err := json.Marshal(ollamaGenerateRequest{
+		Model:  request.Model,
+		Prompt: buildPrompt(request),
+		Stream: false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode ollama request: %w", err)
+	}
+
+	resp, err := p.client.Post(p.cfg.OllamaURL+"/api/generate", "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach ollama at %s: %w", p.cfg.OllamaURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ollama returned status %d", resp.StatusCode)
+	}
+
+	var out ollamaGenerateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode ollama response: %w", err)
+	}
+
+	return &types.ScriptResponse{
+		TaskDescription: request.TaskDescription,
+		Model:           request.Model,
+		Provider:        "ollama",
+		ScriptType:      request.ScriptType,
+		Script:          out.Response,
+	}, nil
+}
+
+func buildPrompt(request *types.ScriptRequest) string {
+	return fmt.Sprintf("Write a %s script that: %s", request.ScriptType, request.TaskDescription)
+}
+
+type ollamaTagsResponse struct {
+	Models []struct {
+		Name string `json:"name"`
+	} `json:"models"`
+}
+
+// HasModel reports whether model is already pulled on the Ollama server.
+func (p *OllamaProvider) HasModel(model string) (bool, error) {
+	resp, err := p.client.Get(p.cfg.OllamaURL + "/api/tags")
+	if err != nil {
+		return false, fmt.Errorf("failed to reach ollama at %s: %w", p.cfg.OllamaURL, err)
+	}
+	defer resp.Body.Close()
+
+	var tags ollamaTagsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
+		return false, fmt.Errorf("failed to decode ollama tags: %w", err)
+	}
+
+	for _, m := range tags.Models {
+		if m.Name == model {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type ollamaPullRequest struct {
+	Name   string `json:"name"`
+	Stream bool   `json:"stream"`
+}
+
+type ollamaPullProgress struct {
+	Status    string `json:"status"`
+	Completed int64  `json:"completed"`
+	Total     int64  `json:"total"`
+}
+
+// Pull downloads model from the Ollama library, reporting streamed progress
+// to onProgress as it goes.
+func (p *OllamaProvider) Pull(model string, onProgress func(status string, completed, total int64)) error {
+	reqBody, err := json.Marshal(ollamaPullRequest{Name: model, Stream: true})
+	if err != nil {
+		return fmt.Errorf("failed to encode ollama pull request: %w", err)
+	}
+
+	resp, err := p.pullClient.Post(p.cfg.OllamaURL+"/api/pull", "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("failed to reach ollama at %s: %w", p.cfg.OllamaURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("ollama returned status %d while pulling %s", resp.StatusCode, model)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var progress ollamaPullProgress
+		if err := decoder.Decode(&progress); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to decode ollama pull progress: %w", err)
+		}
+		if onProgress != nil {
+			onProgress(progress.Status, progress.Completed, progress.Total)
+		}
+	}
+}
+
+// EnsurePulled pulls model if it isn't already present, reporting progress
+// to onProgress.
+func (p *OllamaProvider) EnsurePulled(model string, onProgress func(status string, completed, total int64)) error {
+	has, err := p.HasModel(model)
+	if err != nil {
+		return err
+	}
+	if has {
+		return nil
+	}
+	return p.Pull(model, onProgress)
+}
@@ -0,0 +1,91 @@
+package providers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"please/types"
+)
+
+// OpenAIProvider generates scripts using the OpenAI chat completions API.
+type OpenAIProvider struct {
+	cfg *types.Config
+}
+
+// NewOpenAIProvider builds an OpenAIProvider bound to cfg.
+func NewOpenAIProvider(cfg *types.Config) *OpenAIProvider {
+	return &OpenAIProvider{cfg: cfg}
+}
+
+// IsConfigured reports whether an OpenAI API key is set.
+func (p *OpenAIProvider) IsConfigured(cfg *types.Config) bool {
+	return cfg.OpenAIAPIKey != ""
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatCompletionRequest struct {
+	Model    string          `json:"model"`
+	Messages []openAIMessage `json:"messages"`
+}
+
+type openAIChatCompletionResponse struct {
+	Choices []struct {
+		Message openAIMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// GenerateScript asks OpenAI's chat completions API for a script.
+func (p *OpenAIProvider) GenerateScript(request *types.ScriptRequest) (*types.ScriptResponse, error) {
+	if p.cfg.OpenAIAPIKey == "" {
+		return nil, fmt.Errorf("openai provider is not configured: missing API key")
+	}
+
+	reqBody, err := json.Marshal(openAIChatCompletionRequest{
+		Model: request.Model,
+		Messages: []openAIMessage{
+			{Role: "user", Content: buildPrompt(request)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode openai request: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, "https://api.openai.com/v1/chat/completions", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+p.cfg.OpenAIAPIKey)
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach openai: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openai returned status %d", resp.StatusCode)
+	}
+
+	var out openAIChatCompletionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode openai response: %w", err)
+	}
+	if len(out.Choices) == 0 {
+		return nil, fmt.Errorf("openai returned no choices")
+	}
+
+	return &types.ScriptResponse{
+		TaskDescription: request.TaskDescription,
+		Model:           request.Model,
+		Provider:        "openai",
+		ScriptType:      request.ScriptType,
+		Script:          out.Choices[0].Message.Content,
+	}, nil
+}
@@ -0,0 +1,67 @@
+// Package plugin defines the provider plugin protocol third parties
+// implement to add a new LLM backend without please importing it directly.
+// It uses hashicorp/go-plugin's net/rpc transport, the simpler of the two
+// transports go-plugin supports, since a provider plugin's surface (a
+// handful of request/response calls, no bidirectional streaming back into
+// the host) doesn't need full gRPC.
+package plugin
+
+import (
+	"net/rpc"
+
+	goplugin "github.com/hashicorp/go-plugin"
+
+	"please/types"
+)
+
+// Handshake is the shared handshake config the host and every plugin must
+// agree on before a connection is trusted.
+var Handshake = goplugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "PLEASE_PROVIDER_PLUGIN",
+	MagicCookieValue: "please-provider-v1",
+}
+
+// Description is static metadata a plugin reports about itself.
+type Description struct {
+	Name    string
+	Version string
+}
+
+// Provider is the interface a plugin implements, mirroring
+// providers.Provider but without the shared types.Config (a plugin reads its
+// own configuration, typically from its own environment variables).
+type Provider interface {
+	GenerateScript(request *types.ScriptRequest) (*types.ScriptResponse, error)
+	IsConfigured() (bool, error)
+	Describe() (Description, error)
+	// StreamTokens generates a script like GenerateScript but calls onToken
+	// as each token becomes available instead of returning once at the end.
+	// Because this protocol rides net/rpc rather than gRPC, a plugin's
+	// tokens are collected and replayed to onToken after the call returns
+	// rather than pushed incrementally over the wire; a plugin with nothing
+	// finer-grained than a single response can implement this by calling
+	// onToken once with the whole script.
+	StreamTokens(request *types.ScriptRequest, onToken func(token string)) (*types.ScriptResponse, error)
+}
+
+// ProviderPlugin adapts a Provider to go-plugin's net/rpc plugin interface.
+type ProviderPlugin struct {
+	Impl Provider
+}
+
+func (p *ProviderPlugin) Server(*goplugin.MuxBroker) (interface{}, error) {
+	return &rpcServer{impl: p.Impl}, nil
+}
+
+func (p *ProviderPlugin) Client(b *goplugin.MuxBroker, c *rpc.Client) (interface{}, error) {
+	return &rpcClient{client: c}, nil
+}
+
+// Map is the PluginMap every please-provider-* binary registers with
+// go-plugin's Serve, keyed by the single "provider" plugin it exposes.
+func Map(impl Provider) map[string]goplugin.Plugin {
+	return map[string]goplugin.Plugin{
+		"provider": &ProviderPlugin{Impl: impl},
+	}
+}
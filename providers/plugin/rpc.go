@@ -0,0 +1,102 @@
+package plugin
+
+import (
+	"net/rpc"
+
+	"please/types"
+)
+
+// rpcServer is the net/rpc-exported wrapper a plugin binary runs in-process;
+// go-plugin calls its methods over a local socket on the host's behalf.
+type rpcServer struct {
+	impl Provider
+}
+
+func (s *rpcServer) GenerateScript(request *types.ScriptRequest, resp *types.ScriptResponse) error {
+	result, err := s.impl.GenerateScript(request)
+	if err != nil {
+		return err
+	}
+	*resp = *result
+	return nil
+}
+
+func (s *rpcServer) IsConfigured(_ struct{}, ok *bool) error {
+	configured, err := s.impl.IsConfigured()
+	if err != nil {
+		return err
+	}
+	*ok = configured
+	return nil
+}
+
+func (s *rpcServer) Describe(_ struct{}, desc *Description) error {
+	result, err := s.impl.Describe()
+	if err != nil {
+		return err
+	}
+	*desc = result
+	return nil
+}
+
+// streamTokensResponse carries both the final script and every token the
+// plugin emitted along the way, since net/rpc has no server-push: the
+// client replays Tokens to its caller's onToken after the call returns.
+type streamTokensResponse struct {
+	Tokens   []string
+	Response types.ScriptResponse
+}
+
+func (s *rpcServer) StreamTokens(request *types.ScriptRequest, resp *streamTokensResponse) error {
+	result, err := s.impl.StreamTokens(request, func(token string) {
+		resp.Tokens = append(resp.Tokens, token)
+	})
+	if err != nil {
+		return err
+	}
+	resp.Response = *result
+	return nil
+}
+
+// rpcClient is the host-side stub that satisfies Provider by calling across
+// the net/rpc connection into the plugin process.
+type rpcClient struct {
+	client *rpc.Client
+}
+
+func (c *rpcClient) GenerateScript(request *types.ScriptRequest) (*types.ScriptResponse, error) {
+	var resp types.ScriptResponse
+	if err := c.client.Call("Plugin.GenerateScript", request, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *rpcClient) IsConfigured() (bool, error) {
+	var ok bool
+	if err := c.client.Call("Plugin.IsConfigured", struct{}{}, &ok); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+func (c *rpcClient) Describe() (Description, error) {
+	var desc Description
+	if err := c.client.Call("Plugin.Describe", struct{}{}, &desc); err != nil {
+		return Description{}, err
+	}
+	return desc, nil
+}
+
+func (c *rpcClient) StreamTokens(request *types.ScriptRequest, onToken func(token string)) (*types.ScriptResponse, error) {
+	var resp streamTokensResponse
+	if err := c.client.Call("Plugin.StreamTokens", request, &resp); err != nil {
+		return nil, err
+	}
+	for _, token := range resp.Tokens {
+		if onToken != nil {
+			onToken(token)
+		}
+	}
+	return &resp.Response, nil
+}
@@ -0,0 +1,34 @@
+// Package providers implements the LLM backends please can generate scripts
+// with (Ollama, OpenAI, Anthropic, ...).
+package providers
+
+import (
+	"please/providers/plugin"
+	"please/types"
+)
+
+// Provider generates shell scripts from a ScriptRequest using a specific LLM
+// backend.
+type Provider interface {
+	// GenerateScript turns request into a runnable script plus explanation.
+	GenerateScript(request *types.ScriptRequest) (*types.ScriptResponse, error)
+	// IsConfigured reports whether cfg has everything this provider needs
+	// (API keys, reachable endpoint, ...).
+	IsConfigured(cfg *types.Config) bool
+}
+
+// StreamingProvider is an optional capability a Provider can implement to
+// emit tokens as they're generated instead of only returning the final
+// script; callers should type-assert for it and fall back to
+// GenerateScript when it isn't supported. Only plugin-backed providers
+// implement it today.
+type StreamingProvider interface {
+	StreamTokens(request *types.ScriptRequest, onToken func(token string)) (*types.ScriptResponse, error)
+}
+
+// Describer is an optional capability a Provider can implement to report
+// metadata about itself beyond what Provider exposes. Only plugin-backed
+// providers implement it today.
+type Describer interface {
+	Describe() (plugin.Description, error)
+}
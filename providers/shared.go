@@ -0,0 +1,11 @@
+package providers
+
+import (
+	"net/http"
+	"time"
+)
+
+// httpClient is the shared HTTP client used by providers that speak a REST
+// API (OpenAI, Anthropic); Ollama uses its own since it targets a
+// user-configured, usually local, endpoint.
+var httpClient = &http.Client{Timeout: 60 * time.Second}
@@ -0,0 +1,271 @@
+// Package server exposes an OpenAI-compatible HTTP surface backed by
+// please's own provider/model selection pipeline, so editors and other
+// OpenAI-API-speaking tools can route script-generation requests through
+// please.
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"please/gallery"
+	"please/types"
+)
+
+// Options configures a Server.
+type Options struct {
+	Addr      string
+	APIKey    string
+	Providers []string // allowlist; empty means allow every configured provider
+	// Generate runs the existing script-generation pipeline for request.
+	Generate func(request *types.ScriptRequest) (*types.ScriptResponse, error)
+}
+
+// Server is the OpenAI-compatible HTTP front end for please.
+type Server struct {
+	opts Options
+	mux  *http.ServeMux
+}
+
+// New builds a Server from opts.
+func New(opts Options) *Server {
+	s := &Server{opts: opts, mux: http.NewServeMux()}
+	s.mux.HandleFunc("/v1/chat/completions", s.handleChatCompletions)
+	s.mux.HandleFunc("/v1/completions", s.handleCompletions)
+	s.mux.HandleFunc("/v1/models", s.handleModels)
+	return s
+}
+
+// ListenAndServe starts the HTTP server on opts.Addr.
+func (s *Server) ListenAndServe() error {
+	return http.ListenAndServe(s.opts.Addr, s.withAuth(s.mux))
+}
+
+func (s *Server) withAuth(next http.Handler) http.Handler {
+	if s.opts.APIKey == "" {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if auth != "Bearer "+s.opts.APIKey {
+			http.Error(w, `{"error":"invalid api key"}`, http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) providerAllowed(name string) bool {
+	if len(s.opts.Providers) == 0 {
+		return true
+	}
+	for _, p := range s.opts.Providers {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+}
+
+// handleChatCompletions translates an OpenAI chat request into a
+// types.ScriptRequest, runs the generator, and returns the result in
+// OpenAI's format: as SSE chunks when the request sets stream, otherwise as
+// a single chat.completion JSON object.
+func (s *Server) handleChatCompletions(w http.ResponseWriter, r *http.Request) {
+	var req chatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf(`{"error":"%v"}`, err), http.StatusBadRequest)
+		return
+	}
+
+	g, err := gallery.Load()
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"error":"%v"}`, err), http.StatusInternalServerError)
+		return
+	}
+	provider, scriptType, model := resolveModel(g, req.Model)
+	if !s.providerAllowed(provider) {
+		http.Error(w, fmt.Sprintf(`{"error":"provider %q is not allowed"}`, provider), http.StatusForbidden)
+		return
+	}
+
+	response, err := s.opts.Generate(&types.ScriptRequest{
+		TaskDescription: lastUserMessage(req.Messages),
+		ScriptType:      scriptType,
+		Provider:        provider,
+		Model:           model,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"error":"%v"}`, err), http.StatusBadGateway)
+		return
+	}
+
+	if !req.Stream {
+		writeJSON(w, chatCompletionResponse(req.Model, response.Script))
+		return
+	}
+
+	streamSSE(w, req.Model, response.Script)
+}
+
+// handleCompletions is the legacy /v1/completions shape, answered with the
+// same pipeline as chat completions.
+func (s *Server) handleCompletions(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Model  string `json:"model"`
+		Prompt string `json:"prompt"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf(`{"error":"%v"}`, err), http.StatusBadRequest)
+		return
+	}
+
+	g, err := gallery.Load()
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"error":"%v"}`, err), http.StatusInternalServerError)
+		return
+	}
+	provider, scriptType, model := resolveModel(g, req.Model)
+	if !s.providerAllowed(provider) {
+		http.Error(w, fmt.Sprintf(`{"error":"provider %q is not allowed"}`, provider), http.StatusForbidden)
+		return
+	}
+
+	response, err := s.opts.Generate(&types.ScriptRequest{
+		TaskDescription: req.Prompt,
+		ScriptType:      scriptType,
+		Provider:        provider,
+		Model:           model,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"error":"%v"}`, err), http.StatusBadGateway)
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"id":      "cmpl-please",
+		"object":  "text_completion",
+		"created": time.Now().Unix(),
+		"model":   req.Model,
+		"choices": []map[string]interface{}{
+			{"text": response.Script, "index": 0, "finish_reason": "stop"},
+		},
+	})
+}
+
+// handleModels enumerates the gallery's profiles as OpenAI-style model
+// objects.
+func (s *Server) handleModels(w http.ResponseWriter, r *http.Request) {
+	g, err := gallery.Load()
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"error":"%v"}`, err), http.StatusInternalServerError)
+		return
+	}
+
+	data := make([]map[string]interface{}, 0, len(g.Profiles))
+	for _, p := range g.Profiles {
+		if !s.providerAllowed(p.Provider) {
+			continue
+		}
+		data = append(data, map[string]interface{}{
+			"id":       p.Name,
+			"object":   "model",
+			"owned_by": p.Provider,
+		})
+	}
+
+	writeJSON(w, map[string]interface{}{"object": "list", "data": data})
+}
+
+// resolveModel looks modelID up as a gallery profile name (the ids
+// handleModels advertises, e.g. "gpt-refactor") and returns the profile's
+// real provider/model plus a script type inferred from its task tags. If
+// modelID isn't a known profile, it's treated as a raw "<provider>-model"
+// pair for callers that bypass the gallery entirely; anything else falls
+// back to ollama/bash.
+func resolveModel(g *gallery.Gallery, modelID string) (provider, scriptType, model string) {
+	if profile, ok := g.Find(modelID); ok {
+		return profile.Provider, scriptTypeForProfile(profile), profile.Model
+	}
+
+	parts := strings.SplitN(modelID, "-", 2)
+	if len(parts) == 2 {
+		return parts[0], "bash", parts[1]
+	}
+	return "ollama", "bash", modelID
+}
+
+func scriptTypeForProfile(p gallery.Profile) string {
+	for _, tag := range p.TaskTags {
+		if tag == "powershell" || tag == "bash" {
+			return tag
+		}
+	}
+	return "bash"
+}
+
+func lastUserMessage(messages []chatMessage) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == "user" {
+			return messages[i].Content
+		}
+	}
+	return ""
+}
+
+func chatCompletionResponse(model, script string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":      "chatcmpl-please",
+		"object":  "chat.completion",
+		"created": time.Now().Unix(),
+		"model":   model,
+		"choices": []map[string]interface{}{
+			{
+				"index":         0,
+				"message":       chatMessage{Role: "assistant", Content: script},
+				"finish_reason": "stop",
+			},
+		},
+	}
+}
+
+func streamSSE(w http.ResponseWriter, model, script string) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	flusher, _ := w.(http.Flusher)
+
+	chunk := map[string]interface{}{
+		"id":      "chatcmpl-please",
+		"object":  "chat.completion.chunk",
+		"created": time.Now().Unix(),
+		"model":   model,
+		"choices": []map[string]interface{}{
+			{"index": 0, "delta": chatMessage{Role: "assistant", Content: script}},
+		},
+	}
+	data, _ := json.Marshal(chunk)
+	fmt.Fprintf(w, "data: %s\n\n", data)
+	fmt.Fprint(w, "data: [DONE]\n\n")
+	if flusher != nil {
+		flusher.Flush()
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,33 @@
+// Package types holds the data structures shared between please's config,
+// model selection, and provider packages.
+package types
+
+// Config is the on-disk configuration for please, usually stored at
+// ~/.config/please/config.json.
+type Config struct {
+	Provider        string `json:"provider"`
+	Model           string `json:"model"`
+	ScriptType      string `json:"script_type"`
+	OllamaURL       string `json:"ollama_url,omitempty"`
+	OpenAIAPIKey    string `json:"openai_api_key,omitempty"`
+	AnthropicAPIKey string `json:"anthropic_api_key,omitempty"`
+}
+
+// ScriptRequest describes a single task please should turn into a script.
+type ScriptRequest struct {
+	TaskDescription string `json:"task"`
+	ScriptType      string `json:"script_type"`
+	Provider        string `json:"provider"`
+	Model           string `json:"model"`
+}
+
+// ScriptResponse is the result of generating a script for a ScriptRequest.
+type ScriptResponse struct {
+	TaskDescription string   `json:"task"`
+	Model           string   `json:"model"`
+	Provider        string   `json:"provider"`
+	ScriptType      string   `json:"script_type"`
+	Script          string   `json:"script"`
+	Explanation     string   `json:"explanation"`
+	Warnings        []string `json:"warnings,omitempty"`
+}
@@ -0,0 +1,20 @@
+package ui
+
+// ANSI color and style codes used throughout please's terminal output.
+const (
+	ColorReset  = "\033[0m"
+	ColorBold   = "\033[1m"
+	ColorRed    = "\033[31m"
+	ColorGreen  = "\033[32m"
+	ColorYellow = "\033[33m"
+	ColorCyan   = "\033[36m"
+	ColorPurple = "\033[35m"
+
+	Rainbow1 = "\033[38;5;196m"
+	Rainbow2 = "\033[38;5;208m"
+	Rainbow3 = "\033[38;5;226m"
+	Rainbow4 = "\033[38;5;46m"
+	Rainbow5 = "\033[38;5;21m"
+	Rainbow6 = "\033[38;5;93m"
+	Rainbow7 = "\033[38;5;201m"
+)
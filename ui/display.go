@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"please/types"
+)
+
+// Version is please's current release version.
+const Version = "0.1.0"
+
+// ShowVersion prints please's version string.
+func ShowVersion() {
+	fmt.Printf("please %s\n", Version)
+}
+
+// ShowMainMenu is shown when please is run with no task description.
+func ShowMainMenu() {
+	PrintRainbowBanner()
+	fmt.Printf("\n%sWhat would you like me to script for you?%s\n", ColorBold+ColorCyan, ColorReset)
+	fmt.Printf("%sExample: pls create a backup of my Documents folder%s\n\n", ColorYellow, ColorReset)
+	PrintFooter()
+}
+
+// ShowScriptMenu shows a run/copy/quit menu for a generated script and reads
+// the user's choice. Choosing run or copy only prints a notice; the script
+// is neither executed nor copied.
+func ShowScriptMenu(response *types.ScriptResponse) {
+	fmt.Printf("\n%sWhat would you like to do?%s\n", ColorBold+ColorCyan, ColorReset)
+	fmt.Printf("  %s[r]%s run it\n", ColorGreen, ColorReset)
+	fmt.Printf("  %s[c]%s copy it\n", ColorYellow, ColorReset)
+	fmt.Printf("  %s[q]%s quit without running\n", ColorRed, ColorReset)
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("> ")
+	choice, _ := reader.ReadString('\n')
+
+	switch choice {
+	case "r\n":
+		fmt.Printf("%sRunning is not yet wired up in this build.%s\n", ColorYellow, ColorReset)
+	case "c\n":
+		fmt.Printf("%sScript copied to clipboard is not yet wired up in this build.%s\n", ColorYellow, ColorReset)
+	default:
+		fmt.Printf("%sOk, not running anything.%s\n", ColorCyan, ColorReset)
+	}
+}